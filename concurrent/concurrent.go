@@ -0,0 +1,246 @@
+// Package concurrent provides a thread-safe, blocking queue built on top
+// of the core queue.Queue, suitable for producer/consumer pipelines.
+package concurrent
+
+import (
+	"context"
+	"sync"
+
+	queue "github.com/trigologiaa/queue-in-go"
+)
+
+// A thread-safe FIFO queue supporting both non-blocking and
+// context-aware blocking operations.
+//
+// BlockingQueue[T] wraps a queue.Queue[T] with a mutex and two condition
+// variables: notEmpty, signaled whenever an element is added, and
+// notFull, signaled whenever an element is removed. Constructed with a
+// positive capacity, Put blocks while the queue is full; constructed
+// unbounded (capacity 0), Put never blocks.
+type BlockingQueue[T comparable] struct {
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	q        *queue.Queue[T]
+	capacity int // 0 means unbounded
+}
+
+// Creates and returns a new empty BlockingQueue for type T.
+//
+// Parameters:
+//   - capacity: The maximum number of elements the queue may hold before
+//     Put blocks; 0 means unbounded, in which case Put never blocks.
+//
+// Returns:
+//   - *BlockingQueue[T]: A new empty blocking queue for type T.
+//
+// Example:
+//
+//	bq := concurrent.NewBlockingQueue[int](10)
+//	bq.Offer(1)
+func NewBlockingQueue[T comparable](capacity int) *BlockingQueue[T] {
+	bq := &BlockingQueue[T]{capacity: capacity}
+	if capacity > 0 {
+		bq.q = queue.NewBoundedQueue[T](capacity)
+	} else {
+		bq.q = queue.NewQueue[T]()
+	}
+	bq.notEmpty = sync.NewCond(&bq.mu)
+	bq.notFull = sync.NewCond(&bq.mu)
+	return bq
+}
+
+// Returns the number of elements currently in the queue.
+//
+// Returns:
+//   - int: The count of elements in the queue.
+//
+// Example:
+//
+//	bq := concurrent.NewBlockingQueue[int](0)
+//	bq.Offer(1)
+//	fmt.Println(bq.Size()) // 1
+func (bq *BlockingQueue[T]) Size() int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.q.Size()
+}
+
+// watchContext spawns a goroutine that broadcasts cond once ctx is done,
+// so a waiter blocked in cond.Wait wakes up to re-check ctx.Err(). The
+// returned stop function must be called (with bq.mu held) once the
+// caller is done waiting, to let the goroutine exit.
+func (bq *BlockingQueue[T]) watchContext(ctx context.Context, cond *sync.Cond) func() {
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			bq.mu.Lock()
+			cond.Broadcast()
+			bq.mu.Unlock()
+		case <-done:
+		}
+	}()
+	return func() { close(done) }
+}
+
+// Adds v to the queue without blocking.
+//
+// Parameters:
+//   - v: The element to add.
+//
+// Returns:
+//   - bool: true if v was added; false if the queue is bounded and
+//     already at capacity.
+//
+// Example:
+//
+//	bq := concurrent.NewBlockingQueue[int](1)
+//	bq.Offer(1)          // true
+//	fmt.Println(bq.Offer(2)) // false
+func (bq *BlockingQueue[T]) Offer(v T) bool {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	if bq.capacity > 0 && bq.q.Size() >= bq.capacity {
+		return false
+	}
+	bq.q.Enqueue(v)
+	bq.notEmpty.Signal()
+	return true
+}
+
+// Adds v to the queue, blocking while the queue is full until space
+// becomes available or ctx is done.
+//
+// Parameters:
+//   - ctx: Governs how long Put is willing to block.
+//   - v: The element to add.
+//
+// Returns:
+//   - error: ctx.Err() if ctx was done before space became available;
+//     nil otherwise.
+//
+// Example:
+//
+//	bq := concurrent.NewBlockingQueue[int](1)
+//	bq.Offer(1)
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	err := bq.Put(ctx, 2) // blocks until Take frees a slot or ctx expires
+func (bq *BlockingQueue[T]) Put(ctx context.Context, v T) error {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	stop := bq.watchContext(ctx, bq.notFull)
+	defer stop()
+	for bq.capacity > 0 && bq.q.Size() >= bq.capacity {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		bq.notFull.Wait()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	bq.q.Enqueue(v)
+	bq.notEmpty.Signal()
+	return nil
+}
+
+// Removes and returns the front element without blocking.
+//
+// Returns:
+//   - value: The front element.
+//   - bool: true if an element was removed; false if the queue was
+//     empty.
+//
+// Example:
+//
+//	bq := concurrent.NewBlockingQueue[int](0)
+//	bq.Offer(1)
+//	value, ok := bq.Poll()
+//	if ok {
+//	    fmt.Println(value) // 1
+//	}
+func (bq *BlockingQueue[T]) Poll() (T, bool) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	if bq.q.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	v, _ := bq.q.Dequeue()
+	bq.notFull.Signal()
+	return v, true
+}
+
+// Removes and returns the front element, blocking while the queue is
+// empty until an element becomes available or ctx is done.
+//
+// Parameters:
+//   - ctx: Governs how long Take is willing to block.
+//
+// Returns:
+//   - value: The front element.
+//   - error: ctx.Err() if ctx was done before an element became
+//     available; nil otherwise.
+//
+// Example:
+//
+//	bq := concurrent.NewBlockingQueue[int](0)
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	value, err := bq.Take(ctx) // blocks until Offer/Put adds an element or ctx expires
+func (bq *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	stop := bq.watchContext(ctx, bq.notEmpty)
+	defer stop()
+	for bq.q.IsEmpty() {
+		if err := ctx.Err(); err != nil {
+			var zero T
+			return zero, err
+		}
+		bq.notEmpty.Wait()
+	}
+	if err := ctx.Err(); err != nil {
+		var zero T
+		return zero, err
+	}
+	v, _ := bq.q.Dequeue()
+	bq.notFull.Signal()
+	return v, nil
+}
+
+// Removes up to max elements (and no more than len(dst)) into dst under a
+// single lock acquisition, returning the number actually drained.
+//
+// Parameters:
+//   - dst: The slice to drain into; at most len(dst) elements are
+//     written.
+//   - max: The maximum number of elements to drain.
+//
+// Returns:
+//   - int: The number of elements written to dst.
+//
+// Example:
+//
+//	bq := concurrent.NewBlockingQueue[int](0)
+//	bq.Offer(1)
+//	bq.Offer(2)
+//	dst := make([]int, 10)
+//	n := bq.DrainTo(dst, 10)
+//	fmt.Println(dst[:n]) // [1 2]
+func (bq *BlockingQueue[T]) DrainTo(dst []T, max int) int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	n := 0
+	for n < max && n < len(dst) && !bq.q.IsEmpty() {
+		v, _ := bq.q.Dequeue()
+		dst[n] = v
+		n++
+	}
+	if n > 0 {
+		bq.notFull.Broadcast()
+	}
+	return n
+}
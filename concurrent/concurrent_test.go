@@ -0,0 +1,105 @@
+package concurrent
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestOfferAndPoll(t *testing.T) {
+	bq := NewBlockingQueue[int](1)
+	if !bq.Offer(1) {
+		t.Error("expected Offer to succeed on empty bounded queue")
+	}
+	if bq.Offer(2) {
+		t.Error("expected Offer to fail once the queue is at capacity")
+	}
+	value, ok := bq.Poll()
+	if !ok || value != 1 {
+		t.Errorf("expected Poll to return (1, true), got (%d, %v)", value, ok)
+	}
+	if _, ok := bq.Poll(); ok {
+		t.Error("expected Poll to fail on empty queue")
+	}
+}
+
+func TestPutBlocksUntilSpace(t *testing.T) {
+	bq := NewBlockingQueue[int](1)
+	bq.Offer(1)
+	done := make(chan error, 1)
+	go func() {
+		done <- bq.Put(context.Background(), 2)
+	}()
+	select {
+	case <-done:
+		t.Fatal("expected Put to block while the queue is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if _, ok := bq.Poll(); !ok {
+		t.Fatal("expected Poll to free a slot")
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("unexpected error from Put: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected Put to unblock once a slot freed up")
+	}
+}
+
+func TestPutRespectsContextCancellation(t *testing.T) {
+	bq := NewBlockingQueue[int](1)
+	bq.Offer(1)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := bq.Put(ctx, 2); err == nil {
+		t.Error("expected Put to return an error once the context expires")
+	}
+}
+
+func TestTakeBlocksUntilAvailable(t *testing.T) {
+	bq := NewBlockingQueue[int](0)
+	done := make(chan int, 1)
+	go func() {
+		value, err := bq.Take(context.Background())
+		if err != nil {
+			t.Errorf("unexpected error from Take: %v", err)
+		}
+		done <- value
+	}()
+	time.Sleep(50 * time.Millisecond)
+	bq.Offer(42)
+	select {
+	case value := <-done:
+		if value != 42 {
+			t.Errorf("expected Take to return 42, got %d", value)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected Take to unblock once an element was offered")
+	}
+}
+
+func TestTakeRespectsContextCancellation(t *testing.T) {
+	bq := NewBlockingQueue[int](0)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := bq.Take(ctx); err == nil {
+		t.Error("expected Take to return an error once the context expires")
+	}
+}
+
+func TestDrainTo(t *testing.T) {
+	bq := NewBlockingQueue[int](0)
+	bq.Offer(1)
+	bq.Offer(2)
+	bq.Offer(3)
+	dst := make([]int, 2)
+	n := bq.DrainTo(dst, 10)
+	if n != 2 || dst[0] != 1 || dst[1] != 2 {
+		t.Errorf("expected to drain [1 2], got %v (n=%d)", dst, n)
+	}
+	if bq.Size() != 1 {
+		t.Errorf("expected size 1 after DrainTo, got %d", bq.Size())
+	}
+}
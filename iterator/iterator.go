@@ -0,0 +1,53 @@
+// Package iterator defines generic, stateful cursor abstractions shared by
+// the queue implementations in this module, mirroring the
+// Container/Iterator contracts used by similar collection libraries.
+package iterator
+
+// Iterator provides stateful, forward-only traversal over a sequence of
+// values of type T.
+//
+// A zero-value cursor sits before the first element; Next must be called
+// before the first Value.
+type Iterator[T any] interface {
+	// Next advances the iterator to the next element and reports whether
+	// one was found.
+	Next() bool
+
+	// Value returns the value at the iterator's current position.
+	Value() T
+
+	// Index returns the zero-based logical position of the current
+	// element.
+	Index() int
+
+	// Begin resets the iterator to before the first element, so that the
+	// next call to Next moves it to the first element.
+	Begin()
+
+	// First moves the iterator to the first element and reports whether
+	// one exists.
+	First() bool
+
+	// Err returns the error, if any, that caused the most recent Next (or
+	// Prev, for a ReverseIterator) to return false. It is nil when
+	// traversal simply reached the end of the sequence.
+	Err() error
+}
+
+// ReverseIterator extends Iterator with backward traversal over the same
+// sequence.
+type ReverseIterator[T any] interface {
+	Iterator[T]
+
+	// Prev moves the iterator to the previous element and reports whether
+	// one was found.
+	Prev() bool
+
+	// End resets the iterator to after the last element, so that the
+	// next call to Prev moves it to the last element.
+	End()
+
+	// Last moves the iterator to the last element and reports whether one
+	// exists.
+	Last() bool
+}
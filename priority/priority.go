@@ -0,0 +1,271 @@
+// Package priority provides a generic priority queue implementation for Go.
+//
+// The PriorityQueue type is a binary min-heap parameterized by a Less
+// comparator, so T is not restricted to comparable: any ordering rule the
+// caller supplies decides which element Pop and Peek surface next.
+//
+// Example:
+//
+//	pq := priority.NewPriorityQueue(func(a, b int) bool { return a < b })
+//	pq.Push(5)
+//	pq.Push(1)
+//	pq.Push(3)
+//	value, _ := pq.Pop()
+//	fmt.Println(value) // 1
+package priority
+
+import "fmt"
+
+// A generic priority queue backed by an array binary heap.
+//
+// PriorityQueue[T] holds elements of any type T, ordered by the Less
+// comparator supplied at construction: the root is always the element for
+// which no other element compares as smaller.
+type PriorityQueue[T any] struct {
+	data []T
+	less func(a, b T) bool
+}
+
+// Creates and returns a new empty PriorityQueue for type T, ordered by the
+// given less comparator.
+//
+// Parameters:
+//   - less: Reports whether a has higher priority than b; Pop and Peek
+//     always return an element for which no other element compares as
+//     having higher priority.
+//
+// Returns:
+//   - *PriorityQueue[T]: A new empty priority queue for type T.
+//
+// Example:
+//
+//	pq := priority.NewPriorityQueue(func(a, b int) bool { return a < b })
+//	pq.Push(10)
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{less: less}
+}
+
+// Returns the number of elements currently in the priority queue.
+//
+// Returns:
+//   - int: The count of elements in the priority queue.
+//
+// Example:
+//
+//	pq := priority.NewPriorityQueue(func(a, b int) bool { return a < b })
+//	pq.Push(1)
+//	fmt.Println(pq.Size()) // 1
+func (pq *PriorityQueue[T]) Size() int {
+	return len(pq.data)
+}
+
+// Reports whether the priority queue contains no elements.
+//
+// Returns:
+//   - bool: true if the priority queue is empty; false otherwise.
+//
+// Example:
+//
+//	pq := priority.NewPriorityQueue(func(a, b int) bool { return a < b })
+//	fmt.Println(pq.IsEmpty()) // true
+func (pq *PriorityQueue[T]) IsEmpty() bool {
+	return len(pq.data) == 0
+}
+
+// Adds a new element to the priority queue, restoring heap order by
+// sifting it up from the bottom.
+//
+// Parameters:
+//   - v: The element to be added to the priority queue.
+//
+// Example:
+//
+//	pq := priority.NewPriorityQueue(func(a, b int) bool { return a < b })
+//	pq.Push(5)
+//	pq.Push(1)
+func (pq *PriorityQueue[T]) Push(v T) {
+	pq.data = append(pq.data, v)
+	pq.siftUp(len(pq.data) - 1)
+}
+
+// Removes and returns the highest-priority element, restoring heap order
+// by moving the last element to the root and sifting it down.
+//
+// Returns:
+//   - value: The highest-priority element.
+//   - error: An error if the priority queue is empty.
+//
+// Example:
+//
+//	pq := priority.NewPriorityQueue(func(a, b int) bool { return a < b })
+//	pq.Push(5)
+//	pq.Push(1)
+//	value, err := pq.Pop()
+//	if err == nil {
+//	    fmt.Println(value) // 1
+//	}
+func (pq *PriorityQueue[T]) Pop() (T, error) {
+	if pq.IsEmpty() {
+		var zero T
+		return zero, fmt.Errorf("empty priority queue")
+	}
+	root := pq.data[0]
+	last := len(pq.data) - 1
+	pq.data[0] = pq.data[last]
+	var zero T
+	pq.data[last] = zero
+	pq.data = pq.data[:last]
+	if len(pq.data) > 0 {
+		pq.siftDown(0)
+	}
+	return root, nil
+}
+
+// Returns the highest-priority element without removing it.
+//
+// Returns:
+//   - value: The highest-priority element.
+//   - error: An error if the priority queue is empty.
+//
+// Example:
+//
+//	pq := priority.NewPriorityQueue(func(a, b int) bool { return a < b })
+//	pq.Push(5)
+//	value, err := pq.Peek()
+//	if err == nil {
+//	    fmt.Println(value) // 5
+//	}
+func (pq *PriorityQueue[T]) Peek() (T, error) {
+	if pq.IsEmpty() {
+		var zero T
+		return zero, fmt.Errorf("empty priority queue")
+	}
+	return pq.data[0], nil
+}
+
+// Replaces the element at the given index with v and restores heap order,
+// sifting it up or down depending on how v compares to its parent and
+// children.
+//
+// Parameters:
+//   - index: The position of the element to replace, as seen in no
+//     particular externally meaningful order beyond 0 being the root.
+//   - v: The replacement value.
+//
+// Example:
+//
+//	pq := priority.NewPriorityQueue(func(a, b int) bool { return a < b })
+//	pq.Push(5)
+//	pq.Update(0, 1)
+func (pq *PriorityQueue[T]) Update(index int, v T) {
+	if index < 0 || index >= len(pq.data) {
+		return
+	}
+	old := pq.data[index]
+	pq.data[index] = v
+	if pq.less(v, old) {
+		pq.siftUp(index)
+	} else {
+		pq.siftDown(index)
+	}
+}
+
+// Removes and returns the element at the given index, restoring heap
+// order by moving the last element into its place and sifting it up or
+// down as needed.
+//
+// Parameters:
+//   - index: The position of the element to remove.
+//
+// Returns:
+//   - value: The removed element.
+//   - error: An error if index is out of range.
+//
+// Example:
+//
+//	pq := priority.NewPriorityQueue(func(a, b int) bool { return a < b })
+//	pq.Push(5)
+//	pq.Push(1)
+//	value, err := pq.Remove(0)
+//	if err == nil {
+//	    fmt.Println(value) // 5 or 1, depending on heap layout
+//	}
+func (pq *PriorityQueue[T]) Remove(index int) (T, error) {
+	if index < 0 || index >= len(pq.data) {
+		var zero T
+		return zero, fmt.Errorf("index out of range")
+	}
+	removed := pq.data[index]
+	last := len(pq.data) - 1
+	pq.data[index] = pq.data[last]
+	var zero T
+	pq.data[last] = zero
+	pq.data = pq.data[:last]
+	if index < len(pq.data) {
+		pq.siftDown(index)
+		pq.siftUp(index)
+	}
+	return removed, nil
+}
+
+// Pushes v and immediately pops the highest-priority element, without
+// growing the heap when v would be popped right back out.
+//
+// Parameters:
+//   - v: The element to push.
+//
+// Returns:
+//   - value: v itself if it has higher priority than the current root (or
+//     the queue was empty); otherwise the previous root, after v has
+//     replaced it and the heap has been sifted down.
+//
+// Example:
+//
+//	pq := priority.NewPriorityQueue(func(a, b int) bool { return a < b })
+//	pq.Push(5)
+//	value := pq.PushPop(1)
+//	fmt.Println(value) // 1
+func (pq *PriorityQueue[T]) PushPop(v T) T {
+	if pq.IsEmpty() || pq.less(v, pq.data[0]) {
+		return v
+	}
+	root := pq.data[0]
+	pq.data[0] = v
+	pq.siftDown(0)
+	return root
+}
+
+// siftUp restores heap order by moving the element at index up toward the
+// root while it has higher priority than its parent.
+func (pq *PriorityQueue[T]) siftUp(index int) {
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !pq.less(pq.data[index], pq.data[parent]) {
+			break
+		}
+		pq.data[index], pq.data[parent] = pq.data[parent], pq.data[index]
+		index = parent
+	}
+}
+
+// siftDown restores heap order by moving the element at index down toward
+// the leaves while one of its children has higher priority.
+func (pq *PriorityQueue[T]) siftDown(index int) {
+	n := len(pq.data)
+	for {
+		left := 2*index + 1
+		right := 2*index + 2
+		smallest := index
+		if left < n && pq.less(pq.data[left], pq.data[smallest]) {
+			smallest = left
+		}
+		if right < n && pq.less(pq.data[right], pq.data[smallest]) {
+			smallest = right
+		}
+		if smallest == index {
+			break
+		}
+		pq.data[index], pq.data[smallest] = pq.data[smallest], pq.data[index]
+		index = smallest
+	}
+}
@@ -0,0 +1,123 @@
+package priority
+
+import "testing"
+
+func less(a, b int) bool { return a < b }
+
+func TestPushAndPop(t *testing.T) {
+	pq := NewPriorityQueue(less)
+	pq.Push(5)
+	pq.Push(1)
+	pq.Push(3)
+	if pq.Size() != 3 {
+		t.Errorf("expected size 3, got %d", pq.Size())
+	}
+	want := []int{1, 3, 5}
+	for _, w := range want {
+		value, err := pq.Pop()
+		if err != nil {
+			t.Fatalf("unexpected error on Pop: %v", err)
+		}
+		if value != w {
+			t.Errorf("expected popped value %d, got %d", w, value)
+		}
+	}
+	if !pq.IsEmpty() {
+		t.Error("expected priority queue to be empty after draining")
+	}
+	if _, err := pq.Pop(); err == nil {
+		t.Error("expected error on Pop from empty priority queue")
+	}
+}
+
+func TestPeek(t *testing.T) {
+	pq := NewPriorityQueue(less)
+	if _, err := pq.Peek(); err == nil {
+		t.Error("expected error on Peek from empty priority queue")
+	}
+	pq.Push(4)
+	pq.Push(2)
+	value, err := pq.Peek()
+	if err != nil {
+		t.Fatalf("unexpected error on Peek: %v", err)
+	}
+	if value != 2 {
+		t.Errorf("expected Peek value 2, got %d", value)
+	}
+	if pq.Size() != 2 {
+		t.Errorf("expected Peek not to remove elements, size is %d", pq.Size())
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	pq := NewPriorityQueue(less)
+	pq.Push(5)
+	pq.Push(10)
+	pq.Push(15)
+	pq.Update(0, 20)
+	value, err := pq.Peek()
+	if err != nil {
+		t.Fatalf("unexpected error on Peek: %v", err)
+	}
+	if value != 10 {
+		t.Errorf("expected new root 10 after Update, got %d", value)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	pq := NewPriorityQueue(less)
+	pq.Push(5)
+	pq.Push(1)
+	pq.Push(3)
+	value, err := pq.Remove(1)
+	if err != nil {
+		t.Fatalf("unexpected error on Remove: %v", err)
+	}
+	if pq.Size() != 2 {
+		t.Errorf("expected size 2 after Remove, got %d", pq.Size())
+	}
+	remaining := []int{}
+	for !pq.IsEmpty() {
+		v, _ := pq.Pop()
+		remaining = append(remaining, v)
+	}
+	found := false
+	for _, v := range []int{5, 1, 3} {
+		if v == value {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Remove returned unexpected value %d", value)
+	}
+	if _, err := pq.Remove(0); err == nil {
+		t.Error("expected error on Remove from empty priority queue")
+	}
+}
+
+func TestPushPop(t *testing.T) {
+	pq := NewPriorityQueue(less)
+	pq.Push(5)
+	value := pq.PushPop(1)
+	if value != 1 {
+		t.Errorf("expected PushPop to return 1 when smaller than root, got %d", value)
+	}
+	if pq.Size() != 1 {
+		t.Errorf("expected size to stay 1 after PushPop, got %d", pq.Size())
+	}
+	value = pq.PushPop(10)
+	if value != 5 {
+		t.Errorf("expected PushPop to return previous root 5, got %d", value)
+	}
+	root, _ := pq.Peek()
+	if root != 10 {
+		t.Errorf("expected new root 10 after PushPop, got %d", root)
+	}
+	empty := NewPriorityQueue(less)
+	if v := empty.PushPop(7); v != 7 {
+		t.Errorf("expected PushPop on empty queue to return pushed value, got %d", v)
+	}
+	if !empty.IsEmpty() {
+		t.Error("expected PushPop on empty queue not to grow the heap")
+	}
+}
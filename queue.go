@@ -3,10 +3,14 @@
 //
 // The Queue type supports standard operations such as Enqueue, Dequeue, and Front,
 // along with utility methods like PeekLast, Remove, Contains, Copy, Reverse,
-// ToSlice, and Clear.
+// ToSlice, and Clear. It also works as a full double-ended queue through
+// PushFront, PopBack, PeekAt, InsertAt, and RemoveAt.
 //
-// It is implemented as a wrapper around a Go slice, supporting any comparable type
-// T, and offers dynamic resizing as elements are added or removed.
+// Internally, Queue is backed by a circular buffer (ring buffer) over a
+// power-of-two sized array, so Enqueue and Dequeue are amortized O(1) and
+// never retain references to elements that have already left the queue.
+// The backing array grows by doubling and shrinks by halving once usage
+// drops to a quarter of its capacity or less.
 //
 // Example:
 //
@@ -25,20 +29,45 @@
 package queue
 
 import (
+	"errors"
 	"fmt"
-	"slices"
 )
 
+// minCapacity is the smallest backing array size a non-empty Queue
+// allocates. It must always be a power of two so that logical-to-physical
+// index translation can use a bitmask instead of a modulo.
+const minCapacity = 8
+
+// ErrQueueFull is returned by EnqueueBounded when adding an element would
+// exceed the capacity a queue was created with via NewBoundedQueue.
+var ErrQueueFull = errors.New("queue: capacity exceeded")
+
+// ErrConcurrentModification is surfaced through an iterator's Err method
+// when the queue it is traversing was mutated after the iterator was
+// created or last reset.
+var ErrConcurrentModification = errors.New("queue: concurrent modification during iteration")
+
 // A generic FIFO (First In, First Out) data structure.
 //
 // Queue[T] holds elements of any comparable type T.
 //
-// Internally, it uses a dynamically growing slice to store elements.
+// Internally, it uses a circular buffer over a power-of-two sized array:
+// head is the physical index of the front element and count is the number
+// of elements currently stored. Logical index i maps to the physical index
+// (head+i) & (len(buf)-1).
 type Queue[T comparable] struct {
-	data []T
+	buf        []T
+	head       int
+	count      int
+	capacity   int // 0 means unbounded; otherwise the limit enforced by EnqueueBounded
+	generation int // bumped on every mutation; lets iterators detect concurrent modification
 }
 
-// Creates and returns a new empty Queue for type T.
+// Creates and returns a new empty, unbounded Queue for type T.
+//
+// An unbounded queue grows by doubling and shrinks by halving once usage
+// drops to a quarter of its capacity or less; EnqueueBounded always
+// succeeds on a queue created this way.
 //
 // Returns:
 //   - *Queue[T]: A new empty queue for type T.
@@ -52,7 +81,74 @@ func NewQueue[T comparable]() *Queue[T] {
 	return &Queue[T]{}
 }
 
-// Adds a new element to the end of the queue.
+// Creates and returns a new empty Queue for type T bounded to capacity
+// elements.
+//
+// A bounded queue still grows and shrinks its backing array like an
+// unbounded one, but EnqueueBounded rejects additions once Size reaches
+// capacity. Enqueue itself is never bounded; use EnqueueBounded to respect
+// the limit.
+//
+// Parameters:
+//   - capacity: The maximum number of elements EnqueueBounded will allow.
+//
+// Returns:
+//   - *Queue[T]: A new empty queue bounded to capacity elements.
+//
+// Example:
+//
+//	q := queue.NewBoundedQueue[int](2)
+//	_ = q.EnqueueBounded(1)
+//	_ = q.EnqueueBounded(2)
+//	err := q.EnqueueBounded(3)
+//	fmt.Println(err) // queue: capacity exceeded
+func NewBoundedQueue[T comparable](capacity int) *Queue[T] {
+	return &Queue[T]{capacity: capacity}
+}
+
+// at translates the logical index i (0 == Front) to the element stored at
+// that position. The caller must ensure 0 <= i < q.count.
+func (q *Queue[T]) at(i int) T {
+	return q.buf[(q.head+i)&(len(q.buf)-1)]
+}
+
+// setAt stores v at the logical index i. The caller must ensure
+// 0 <= i < q.count.
+func (q *Queue[T]) setAt(i int, v T) {
+	q.buf[(q.head+i)&(len(q.buf)-1)] = v
+}
+
+// grow doubles the backing array, or allocates one of minCapacity if the
+// queue has never held an element.
+func (q *Queue[T]) grow() {
+	newCap := minCapacity
+	if len(q.buf) > 0 {
+		newCap = len(q.buf) * 2
+	}
+	q.resize(newCap)
+}
+
+// shrinkIfNeeded halves the backing array once the queue is at most a
+// quarter full, stopping at minCapacity.
+func (q *Queue[T]) shrinkIfNeeded() {
+	if len(q.buf) > minCapacity && q.count <= len(q.buf)/4 {
+		q.resize(len(q.buf) / 2)
+	}
+}
+
+// resize reallocates the backing array to newCap, copying existing
+// elements so that the new buffer starts at physical index 0.
+func (q *Queue[T]) resize(newCap int) {
+	newBuf := make([]T, newCap)
+	for i := 0; i < q.count; i++ {
+		newBuf[i] = q.at(i)
+	}
+	q.buf = newBuf
+	q.head = 0
+}
+
+// Adds a new element to the end of the queue, growing the backing array
+// if it is full.
 //
 // Parameters:
 //   - data: The element to be added to the queue.
@@ -63,7 +159,60 @@ func NewQueue[T comparable]() *Queue[T] {
 //	q.Enqueue("hello")
 //	q.Enqueue("world")
 func (q *Queue[T]) Enqueue(data T) {
-	q.data = append(q.data, data)
+	if q.count == len(q.buf) {
+		q.grow()
+	}
+	q.setAt(q.count, data)
+	q.count++
+	q.generation++
+}
+
+// Adds a new element to the end of the queue, failing once the queue has
+// reached the capacity it was created with via NewBoundedQueue.
+//
+// Parameters:
+//   - data: The element to be added to the queue.
+//
+// Returns:
+//   - error: ErrQueueFull if the queue is bounded and already at capacity;
+//     nil otherwise.
+//
+// Example:
+//
+//	q := queue.NewBoundedQueue[int](1)
+//	if err := q.EnqueueBounded(1); err != nil {
+//	    fmt.Println(err)
+//	}
+//	err := q.EnqueueBounded(2)
+//	fmt.Println(err) // queue: capacity exceeded
+func (q *Queue[T]) EnqueueBounded(data T) error {
+	if q.capacity > 0 && q.count >= q.capacity {
+		return ErrQueueFull
+	}
+	q.Enqueue(data)
+	return nil
+}
+
+// Adds a new element to the front of the queue, growing the backing array
+// if it is full.
+//
+// Parameters:
+//   - data: The element to be added to the front of the queue.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(2)
+//	q.PushFront(1)
+//	fmt.Println(q) // Queue: [1 2]
+func (q *Queue[T]) PushFront(data T) {
+	if q.count == len(q.buf) {
+		q.grow()
+	}
+	q.head = (q.head - 1) & (len(q.buf) - 1)
+	q.count++
+	q.setAt(0, data)
+	q.generation++
 }
 
 // Removes and returns the front element of the queue.
@@ -87,9 +236,43 @@ func (q *Queue[T]) Dequeue() (T, error) {
 		var zero T
 		return zero, fmt.Errorf("empty queue")
 	}
-	head := q.data[0]
-	q.data = q.data[1:]
-	return head, nil
+	value := q.at(0)
+	var zero T
+	q.setAt(0, zero) // drop the reference so the GC can reclaim it
+	q.head = (q.head + 1) & (len(q.buf) - 1)
+	q.count--
+	q.generation++
+	q.shrinkIfNeeded()
+	return value, nil
+}
+
+// Removes and returns the last element of the queue.
+//
+// Returns:
+//   - value: The last element of the queue.
+//   - error: An error if the queue is empty.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	value, err := q.PopBack()
+//	if err == nil {
+//	    fmt.Println(value) // 2
+//	}
+func (q *Queue[T]) PopBack() (T, error) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, fmt.Errorf("empty queue")
+	}
+	value := q.at(q.count - 1)
+	var zero T
+	q.setAt(q.count-1, zero)
+	q.count--
+	q.generation++
+	q.shrinkIfNeeded()
+	return value, nil
 }
 
 // Returns the front element of the queue without removing it.
@@ -111,8 +294,34 @@ func (q *Queue[T]) Front() (T, error) {
 		var zero T
 		return zero, fmt.Errorf("empty queue")
 	}
-	head := q.data[0]
-	return head, nil
+	return q.at(0), nil
+}
+
+// Returns the element at the given logical index without removing it,
+// where 0 is Front and Size()-1 is PeekLast.
+//
+// Parameters:
+//   - i: The non-negative logical index to look up.
+//
+// Returns:
+//   - value: The element at index i.
+//   - error: An error if i is out of range.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(10)
+//	q.Enqueue(20)
+//	value, err := q.PeekAt(1)
+//	if err == nil {
+//	    fmt.Println(value) // 20
+//	}
+func (q *Queue[T]) PeekAt(i int) (T, error) {
+	if i < 0 || i >= q.count {
+		var zero T
+		return zero, fmt.Errorf("index out of range")
+	}
+	return q.at(i), nil
 }
 
 // Reports whether the queue contains no elements.
@@ -125,7 +334,7 @@ func (q *Queue[T]) Front() (T, error) {
 //	q := queue.NewQueue[int]()
 //	fmt.Println(q.IsEmpty()) // true
 func (q *Queue[T]) IsEmpty() bool {
-	return len(q.data) == 0
+	return q.count == 0
 }
 
 // Returns the number of elements currently in the queue.
@@ -140,7 +349,7 @@ func (q *Queue[T]) IsEmpty() bool {
 //	q.Enqueue(2)
 //	fmt.Println(q.Size()) // 2
 func (q *Queue[T]) Size() int {
-	return len(q.data)
+	return q.count
 }
 
 // Removes all elements from the queue, resetting it to empty.
@@ -152,7 +361,10 @@ func (q *Queue[T]) Size() int {
 //	q.Clear()
 //	fmt.Println(q.IsEmpty()) // true
 func (q *Queue[T]) Clear() {
-	q.data = make([]T, 0)
+	q.buf = nil
+	q.head = 0
+	q.count = 0
+	q.generation++
 }
 
 // Returns a string representation of the queue.
@@ -167,7 +379,7 @@ func (q *Queue[T]) Clear() {
 //	q.Enqueue(2)
 //	fmt.Println(q.String()) // Queue: [1 2]
 func (q *Queue[T]) String() string {
-	return fmt.Sprintf("Queue: %v", q.data)
+	return fmt.Sprintf("Queue: %v", q.ToSlice())
 }
 
 // Returns the last element of the queue without removing it.
@@ -190,7 +402,7 @@ func (q *Queue[T]) PeekLast() (T, error) {
 		var zero T
 		return zero, fmt.Errorf("empty queue")
 	}
-	return q.data[q.Size()-1], nil
+	return q.at(q.count - 1), nil
 }
 
 // Reports whether the queue contains the given value.
@@ -208,13 +420,18 @@ func (q *Queue[T]) PeekLast() (T, error) {
 //	fmt.Println(q.Contains(10)) // true
 //	fmt.Println(q.Contains(5))  // false
 func (q *Queue[T]) Contains(data T) bool {
-	return slices.Contains(q.data, data)
+	for i := 0; i < q.count; i++ {
+		if q.at(i) == data {
+			return true
+		}
+	}
+	return false
 }
 
-// Returns a copy of the queue's elements as a slice.
+// Returns a copy of the queue's elements as a slice, in FIFO order.
 //
 // Returns:
-//   - []T: A copy of the queue's internal slice.
+//   - []T: A copy of the queue's elements.
 //
 // Example:
 //
@@ -224,8 +441,10 @@ func (q *Queue[T]) Contains(data T) bool {
 //	slice := q.ToSlice()
 //	fmt.Println(slice) // [1 2]
 func (q *Queue[T]) ToSlice() []T {
-	result := make([]T, q.Size())
-	copy(result, q.data)
+	result := make([]T, q.count)
+	for i := 0; i < q.count; i++ {
+		result[i] = q.at(i)
+	}
 	return result
 }
 
@@ -245,15 +464,122 @@ func (q *Queue[T]) ToSlice() []T {
 //	q.Remove(1)
 //	fmt.Println(q) // Queue: [2]
 func (q *Queue[T]) Remove(data T) bool {
-	for i, v := range q.data {
-		if v == data {
-			q.data = slices.Delete(q.data, i, i+1)
+	for i := 0; i < q.count; i++ {
+		if q.at(i) == data {
+			q.removeAt(i)
 			return true
 		}
 	}
 	return false
 }
 
+// removeAt deletes the element at logical index i by shifting the
+// elements after it forward by one. The caller must ensure
+// 0 <= i < q.count.
+func (q *Queue[T]) removeAt(i int) {
+	for j := i; j < q.count-1; j++ {
+		q.setAt(j, q.at(j+1))
+	}
+	var zero T
+	q.setAt(q.count-1, zero)
+	q.count--
+	q.generation++
+	q.shrinkIfNeeded()
+}
+
+// removeAtFront deletes the element at logical index i by shifting the
+// elements before it back by one and dropping the new front element. The
+// caller must ensure 0 <= i < q.count.
+func (q *Queue[T]) removeAtFront(i int) {
+	for j := i; j > 0; j-- {
+		q.setAt(j, q.at(j-1))
+	}
+	var zero T
+	q.setAt(0, zero)
+	q.head = (q.head + 1) & (len(q.buf) - 1)
+	q.count--
+	q.generation++
+	q.shrinkIfNeeded()
+}
+
+// Inserts v at logical index i, shifting whichever side (front or back)
+// of the queue is shorter to make room, for O(min(i, Size()-i)) cost.
+//
+// Parameters:
+//   - i: The non-negative logical index v should end up at; must be in
+//     [0, Size()].
+//   - v: The value to insert.
+//
+// Returns:
+//   - error: An error if i is out of range.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(3)
+//	q.InsertAt(1, 2)
+//	fmt.Println(q) // Queue: [1 2 3]
+func (q *Queue[T]) InsertAt(i int, v T) error {
+	if i < 0 || i > q.count {
+		return fmt.Errorf("index out of range")
+	}
+	if q.count == len(q.buf) {
+		q.grow()
+	}
+	if i <= q.count-i {
+		q.head = (q.head - 1) & (len(q.buf) - 1)
+		q.count++
+		for j := 0; j < i; j++ {
+			q.setAt(j, q.at(j+1))
+		}
+	} else {
+		q.count++
+		for j := q.count - 1; j > i; j-- {
+			q.setAt(j, q.at(j-1))
+		}
+	}
+	q.setAt(i, v)
+	q.generation++
+	return nil
+}
+
+// Removes and returns the element at logical index i, shifting whichever
+// side (front or back) of the queue is shorter to close the gap, for
+// O(min(i, Size()-1-i)) cost.
+//
+// Parameters:
+//   - i: The non-negative logical index to remove.
+//
+// Returns:
+//   - value: The removed element.
+//   - error: An error if i is out of range.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	q.Enqueue(3)
+//	value, err := q.RemoveAt(1)
+//	if err == nil {
+//	    fmt.Println(value) // 2
+//	}
+//	fmt.Println(q) // Queue: [1 3]
+func (q *Queue[T]) RemoveAt(i int) (T, error) {
+	if i < 0 || i >= q.count {
+		var zero T
+		return zero, fmt.Errorf("index out of range")
+	}
+	value := q.at(i)
+	if i <= q.count-1-i {
+		q.removeAtFront(i)
+	} else {
+		q.removeAt(i)
+	}
+	return value, nil
+}
+
 // Creates and returns a deep copy of the queue.
 //
 // Returns:
@@ -266,9 +592,11 @@ func (q *Queue[T]) Remove(data T) bool {
 //	clone := q.Copy()
 //	fmt.Println(clone) // Queue: [1]
 func (q *Queue[T]) Copy() *Queue[T] {
-	newData := make([]T, q.Size())
-	copy(newData, q.data)
-	return &Queue[T]{data: newData}
+	newBuf := make([]T, len(q.buf))
+	for i := 0; i < q.count; i++ {
+		newBuf[i] = q.at(i)
+	}
+	return &Queue[T]{buf: newBuf, count: q.count, capacity: q.capacity}
 }
 
 // Reverses the order of elements in the queue.
@@ -282,7 +610,10 @@ func (q *Queue[T]) Copy() *Queue[T] {
 //	q.Reverse()
 //	fmt.Println(q) // Queue: [3 2 1]
 func (q *Queue[T]) Reverse() {
-	for i, j := 0, q.Size()-1; i < j; i, j = i+1, j-1 {
-		q.data[i], q.data[j] = q.data[j], q.data[i]
+	for i, j := 0, q.count-1; i < j; i, j = i+1, j-1 {
+		vi, vj := q.at(i), q.at(j)
+		q.setAt(i, vj)
+		q.setAt(j, vi)
 	}
+	q.generation++
 }
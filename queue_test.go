@@ -1,6 +1,9 @@
 package queue
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestEnqueueAndDequeue(t *testing.T) {
 	q := NewQueue[int]()
@@ -177,3 +180,135 @@ func TestString(t *testing.T) {
 		t.Errorf("expected %q, got %q", want, got)
 	}
 }
+
+func TestRingBufferWrapAround(t *testing.T) {
+	q := NewQueue[int]()
+	for i := 0; i < 20; i++ {
+		q.Enqueue(i)
+	}
+	for i := 0; i < 11; i++ {
+		value, err := q.Dequeue()
+		if err != nil {
+			t.Fatalf("unexpected error on Dequeue: %v", err)
+		}
+		if value != i {
+			t.Errorf("expected dequeued value %d, got %d", i, value)
+		}
+	}
+	for i := 20; i < 35; i++ {
+		q.Enqueue(i)
+	}
+	for i := 11; i < 35; i++ {
+		value, err := q.Dequeue()
+		if err != nil {
+			t.Fatalf("unexpected error on Dequeue: %v", err)
+		}
+		if value != i {
+			t.Errorf("expected dequeued value %d, got %d", i, value)
+		}
+	}
+	if !q.IsEmpty() {
+		t.Error("expected queue to be empty after draining all elements")
+	}
+}
+
+func TestEnqueueBounded(t *testing.T) {
+	q := NewBoundedQueue[int](2)
+	if err := q.EnqueueBounded(1); err != nil {
+		t.Errorf("unexpected error on EnqueueBounded: %v", err)
+	}
+	if err := q.EnqueueBounded(2); err != nil {
+		t.Errorf("unexpected error on EnqueueBounded: %v", err)
+	}
+	if err := q.EnqueueBounded(3); !errors.Is(err, ErrQueueFull) {
+		t.Errorf("expected ErrQueueFull, got %v", err)
+	}
+	if q.Size() != 2 {
+		t.Errorf("expected size 2, got %d", q.Size())
+	}
+	if _, err := q.Dequeue(); err != nil {
+		t.Fatalf("unexpected error on Dequeue: %v", err)
+	}
+	if err := q.EnqueueBounded(3); err != nil {
+		t.Errorf("unexpected error on EnqueueBounded after freeing space: %v", err)
+	}
+}
+
+func TestPushFrontAndPopBack(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(2)
+	q.Enqueue(3)
+	q.PushFront(1)
+	if got := q.ToSlice(); len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("unexpected slice content after PushFront: %v", got)
+	}
+	value, err := q.PopBack()
+	if err != nil {
+		t.Fatalf("unexpected error on PopBack: %v", err)
+	}
+	if value != 3 {
+		t.Errorf("expected PopBack value 3, got %d", value)
+	}
+	if q.Size() != 2 {
+		t.Errorf("expected size 2 after PopBack, got %d", q.Size())
+	}
+	q.Clear()
+	if _, err := q.PopBack(); err == nil {
+		t.Error("expected error on PopBack from empty queue")
+	}
+}
+
+func TestPeekAt(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(10)
+	q.Enqueue(20)
+	q.Enqueue(30)
+	value, err := q.PeekAt(1)
+	if err != nil {
+		t.Fatalf("unexpected error on PeekAt: %v", err)
+	}
+	if value != 20 {
+		t.Errorf("expected PeekAt(1) to be 20, got %d", value)
+	}
+	if _, err := q.PeekAt(-1); err == nil {
+		t.Error("expected error on PeekAt with negative index")
+	}
+	if _, err := q.PeekAt(3); err == nil {
+		t.Error("expected error on PeekAt with out-of-range index")
+	}
+}
+
+func TestInsertAtAndRemoveAt(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(3)
+	q.Enqueue(4)
+	if err := q.InsertAt(1, 2); err != nil {
+		t.Fatalf("unexpected error on InsertAt: %v", err)
+	}
+	if got := q.ToSlice(); len(got) != 4 || got[0] != 1 || got[1] != 2 || got[2] != 3 || got[3] != 4 {
+		t.Errorf("unexpected slice content after InsertAt: %v", got)
+	}
+	if err := q.InsertAt(4, 5); err != nil {
+		t.Fatalf("unexpected error on InsertAt at back: %v", err)
+	}
+	if last, _ := q.PeekLast(); last != 5 {
+		t.Errorf("expected last value 5 after InsertAt at back, got %d", last)
+	}
+	if err := q.InsertAt(10, 99); err == nil {
+		t.Error("expected error on InsertAt with out-of-range index")
+	}
+	value, err := q.RemoveAt(2)
+	if err != nil {
+		t.Fatalf("unexpected error on RemoveAt: %v", err)
+	}
+	if value != 3 {
+		t.Errorf("expected RemoveAt(2) to return 3, got %d", value)
+	}
+	if got := q.ToSlice(); len(got) != 4 || got[0] != 1 || got[1] != 2 || got[2] != 4 || got[3] != 5 {
+		t.Errorf("unexpected slice content after RemoveAt: %v", got)
+	}
+	if _, err := q.RemoveAt(10); err == nil {
+		t.Error("expected error on RemoveAt with out-of-range index")
+	}
+}
@@ -0,0 +1,153 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+)
+
+// Marshals the queue as a JSON array of its elements, in FIFO order.
+//
+// Returns:
+//   - []byte: The JSON encoding of the queue's elements.
+//   - error: An error if any element fails to marshal.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	data, _ := q.MarshalJSON()
+//	fmt.Println(string(data)) // [1,2]
+func (q *Queue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.ToSlice())
+}
+
+// Rebuilds the queue from a JSON array, re-enqueuing the decoded elements
+// in order. Any elements already in the queue are discarded first.
+//
+// Parameters:
+//   - data: The JSON array to decode.
+//
+// Returns:
+//   - error: An error if data is not a valid JSON array of T.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	err := q.UnmarshalJSON([]byte("[1,2,3]"))
+//	if err == nil {
+//	    fmt.Println(q) // Queue: [1 2 3]
+//	}
+func (q *Queue[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	q.buf = nil
+	q.head = 0
+	q.count = 0
+	q.generation++
+	for _, v := range items {
+		q.Enqueue(v)
+	}
+	return nil
+}
+
+// Returns the JSON encoding of the queue, in FIFO order.
+//
+// Returns:
+//   - []byte: The JSON encoding of the queue's elements.
+//   - error: An error if any element fails to marshal.
+//
+// Example:
+//
+//	q := queue.NewQueue[string]()
+//	q.Enqueue("a")
+//	data, _ := q.ToJSON()
+//	fmt.Println(string(data)) // ["a"]
+func (q *Queue[T]) ToJSON() ([]byte, error) {
+	return q.MarshalJSON()
+}
+
+// Rebuilds the queue from its JSON encoding, re-enqueuing the decoded
+// elements in order.
+//
+// Parameters:
+//   - data: The JSON array to decode.
+//
+// Returns:
+//   - error: An error if data is not a valid JSON array of T.
+//
+// Example:
+//
+//	q := queue.NewQueue[string]()
+//	err := q.FromJSON([]byte(`["a","b"]`))
+func (q *Queue[T]) FromJSON(data []byte) error {
+	return q.UnmarshalJSON(data)
+}
+
+// Marshals the queue into a compact binary form: a varint header holding
+// the length of the payload that follows, then the queue's elements
+// (in FIFO order) gob-encoded.
+//
+// Returns:
+//   - []byte: The binary encoding of the queue.
+//   - error: An error if any element fails to encode.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(1)
+//	data, err := q.MarshalBinary()
+func (q *Queue[T]) MarshalBinary() ([]byte, error) {
+	var payload bytes.Buffer
+	if err := gob.NewEncoder(&payload).Encode(q.ToSlice()); err != nil {
+		return nil, err
+	}
+	header := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(header, uint64(payload.Len()))
+	result := make([]byte, 0, n+payload.Len())
+	result = append(result, header[:n]...)
+	result = append(result, payload.Bytes()...)
+	return result, nil
+}
+
+// Rebuilds the queue from the binary form produced by MarshalBinary,
+// re-enqueuing the decoded elements in order. Any elements already in the
+// queue are discarded first.
+//
+// Parameters:
+//   - data: The binary encoding to decode.
+//
+// Returns:
+//   - error: An error if data is malformed or its payload fails to decode.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	err := q.UnmarshalBinary(data)
+func (q *Queue[T]) UnmarshalBinary(data []byte) error {
+	length, n := binary.Uvarint(data)
+	if n <= 0 {
+		return fmt.Errorf("queue: invalid binary header")
+	}
+	payload := data[n:]
+	if uint64(len(payload)) != length {
+		return fmt.Errorf("queue: binary payload length mismatch")
+	}
+	var items []T
+	if err := gob.NewDecoder(bytes.NewReader(payload)).Decode(&items); err != nil {
+		return err
+	}
+	q.buf = nil
+	q.head = 0
+	q.count = 0
+	q.generation++
+	for _, v := range items {
+		q.Enqueue(v)
+	}
+	return nil
+}
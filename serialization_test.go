@@ -0,0 +1,90 @@
+package queue
+
+import "testing"
+
+type point struct {
+	X int
+	Y int
+}
+
+func TestJSONRoundTripInt(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	data, err := q.ToJSON()
+	if err != nil {
+		t.Fatalf("unexpected error on ToJSON: %v", err)
+	}
+	restored := NewQueue[int]()
+	if err := restored.FromJSON(data); err != nil {
+		t.Fatalf("unexpected error on FromJSON: %v", err)
+	}
+	if got, want := restored.ToSlice(), q.ToSlice(); len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	} else {
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("expected %v, got %v", want, got)
+				break
+			}
+		}
+	}
+}
+
+func TestJSONRoundTripString(t *testing.T) {
+	q := NewQueue[string]()
+	q.Enqueue("foo")
+	q.Enqueue("bar")
+	data, err := q.ToJSON()
+	if err != nil {
+		t.Fatalf("unexpected error on ToJSON: %v", err)
+	}
+	restored := NewQueue[string]()
+	if err := restored.FromJSON(data); err != nil {
+		t.Fatalf("unexpected error on FromJSON: %v", err)
+	}
+	got := restored.ToSlice()
+	if len(got) != 2 || got[0] != "foo" || got[1] != "bar" {
+		t.Errorf("unexpected slice content after round-trip: %v", got)
+	}
+}
+
+func TestJSONRoundTripStruct(t *testing.T) {
+	q := NewQueue[point]()
+	q.Enqueue(point{X: 1, Y: 2})
+	q.Enqueue(point{X: 3, Y: 4})
+	data, err := q.ToJSON()
+	if err != nil {
+		t.Fatalf("unexpected error on ToJSON: %v", err)
+	}
+	restored := NewQueue[point]()
+	if err := restored.FromJSON(data); err != nil {
+		t.Fatalf("unexpected error on FromJSON: %v", err)
+	}
+	got := restored.ToSlice()
+	if len(got) != 2 || got[0] != (point{1, 2}) || got[1] != (point{3, 4}) {
+		t.Errorf("unexpected slice content after round-trip: %v", got)
+	}
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	q := NewQueue[point]()
+	q.Enqueue(point{X: 5, Y: 6})
+	q.Enqueue(point{X: 7, Y: 8})
+	data, err := q.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error on MarshalBinary: %v", err)
+	}
+	restored := NewQueue[point]()
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error on UnmarshalBinary: %v", err)
+	}
+	got := restored.ToSlice()
+	if len(got) != 2 || got[0] != (point{5, 6}) || got[1] != (point{7, 8}) {
+		t.Errorf("unexpected slice content after round-trip: %v", got)
+	}
+	if err := restored.UnmarshalBinary([]byte{0xff}); err == nil {
+		t.Error("expected error decoding malformed binary data")
+	}
+}
@@ -0,0 +1,271 @@
+package queue
+
+import "github.com/trigologiaa/queue-in-go/iterator"
+
+// queueCursor is a stateful cursor over a Queue[T], implementing both
+// iterator.Iterator[T] and iterator.ReverseIterator[T]. It tracks the
+// generation of the queue it was created from so that a mutation between
+// two calls to Next or Prev is reported through Err rather than silently
+// producing garbage results.
+type queueCursor[T comparable] struct {
+	q          *Queue[T]
+	index      int
+	generation int
+	err        error
+}
+
+// Returns a forward iterator over the queue's elements in FIFO order.
+//
+// The iterator starts positioned before the first element. Mutating the
+// queue after the iterator is created causes the next call to Next to
+// return false with ErrConcurrentModification available from Err.
+//
+// Returns:
+//   - iterator.Iterator[T]: A forward iterator over the queue.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	it := q.Iterator()
+//	for it.Next() {
+//	    fmt.Println(it.Index(), it.Value())
+//	}
+func (q *Queue[T]) Iterator() iterator.Iterator[T] {
+	return &queueCursor[T]{q: q, index: -1, generation: q.generation}
+}
+
+// Returns a reverse iterator over the queue's elements, from PeekLast back
+// to Front.
+//
+// The iterator starts positioned after the last element. Mutating the
+// queue after the iterator is created causes the next call to Prev to
+// return false with ErrConcurrentModification available from Err.
+//
+// Returns:
+//   - iterator.ReverseIterator[T]: A reverse iterator over the queue.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	it := q.ReverseIterator()
+//	for it.Prev() {
+//	    fmt.Println(it.Index(), it.Value())
+//	}
+func (q *Queue[T]) ReverseIterator() iterator.ReverseIterator[T] {
+	return &queueCursor[T]{q: q, index: q.count, generation: q.generation}
+}
+
+// checkModified reports whether the queue has mutated since c was created
+// or last reset, recording ErrConcurrentModification if so.
+func (c *queueCursor[T]) checkModified() bool {
+	if c.generation != c.q.generation {
+		c.err = ErrConcurrentModification
+		return true
+	}
+	return false
+}
+
+func (c *queueCursor[T]) Next() bool {
+	if c.err != nil || c.checkModified() {
+		return false
+	}
+	if c.index+1 >= c.q.count {
+		return false
+	}
+	c.index++
+	return true
+}
+
+func (c *queueCursor[T]) Prev() bool {
+	if c.err != nil || c.checkModified() {
+		return false
+	}
+	if c.index-1 < 0 {
+		return false
+	}
+	c.index--
+	return true
+}
+
+func (c *queueCursor[T]) Value() T {
+	return c.q.at(c.index)
+}
+
+func (c *queueCursor[T]) Index() int {
+	return c.index
+}
+
+func (c *queueCursor[T]) Begin() {
+	c.index = -1
+	c.generation = c.q.generation
+	c.err = nil
+}
+
+func (c *queueCursor[T]) End() {
+	c.index = c.q.count
+	c.generation = c.q.generation
+	c.err = nil
+}
+
+func (c *queueCursor[T]) First() bool {
+	c.Begin()
+	return c.Next()
+}
+
+func (c *queueCursor[T]) Last() bool {
+	c.End()
+	return c.Prev()
+}
+
+func (c *queueCursor[T]) Err() error {
+	return c.err
+}
+
+// Calls fn for each element in the queue in FIFO order, passing its
+// logical index alongside the value.
+//
+// Parameters:
+//   - fn: Called once per element as fn(index, value).
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(10)
+//	q.Enqueue(20)
+//	q.Each(func(i, v int) { fmt.Println(i, v) })
+func (q *Queue[T]) Each(fn func(i int, v T)) {
+	for i := 0; i < q.count; i++ {
+		fn(i, q.at(i))
+	}
+}
+
+// Returns a new queue holding the result of applying fn to each element,
+// in FIFO order.
+//
+// Parameters:
+//   - fn: Applied to each element to produce the corresponding result.
+//
+// Returns:
+//   - *Queue[T]: A new queue of the mapped values.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	doubled := q.Map(func(v int) int { return v * 2 })
+//	fmt.Println(doubled) // Queue: [2 4]
+func (q *Queue[T]) Map(fn func(T) T) *Queue[T] {
+	result := NewQueue[T]()
+	for i := 0; i < q.count; i++ {
+		result.Enqueue(fn(q.at(i)))
+	}
+	return result
+}
+
+// Returns a new queue holding only the elements for which fn reports
+// true, in FIFO order.
+//
+// Parameters:
+//   - fn: Reports whether an element should be kept.
+//
+// Returns:
+//   - *Queue[T]: A new queue of the selected elements.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	q.Enqueue(3)
+//	evens := q.Select(func(v int) bool { return v%2 == 0 })
+//	fmt.Println(evens) // Queue: [2]
+func (q *Queue[T]) Select(fn func(T) bool) *Queue[T] {
+	result := NewQueue[T]()
+	for i := 0; i < q.count; i++ {
+		if v := q.at(i); fn(v) {
+			result.Enqueue(v)
+		}
+	}
+	return result
+}
+
+// Reports whether fn returns true for at least one element.
+//
+// Parameters:
+//   - fn: The predicate to test each element with.
+//
+// Returns:
+//   - bool: true if fn returned true for any element; false otherwise.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(3)
+//	fmt.Println(q.Any(func(v int) bool { return v%2 == 0 })) // false
+func (q *Queue[T]) Any(fn func(T) bool) bool {
+	for i := 0; i < q.count; i++ {
+		if fn(q.at(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reports whether fn returns true for every element.
+//
+// Parameters:
+//   - fn: The predicate to test each element with.
+//
+// Returns:
+//   - bool: true if fn returned true for every element (including an
+//     empty queue); false otherwise.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(2)
+//	q.Enqueue(4)
+//	fmt.Println(q.All(func(v int) bool { return v%2 == 0 })) // true
+func (q *Queue[T]) All(fn func(T) bool) bool {
+	for i := 0; i < q.count; i++ {
+		if !fn(q.at(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the first element, in FIFO order, for which fn reports true.
+//
+// Parameters:
+//   - fn: The predicate to test each element with.
+//
+// Returns:
+//   - value: The first matching element, or the zero value of T if none
+//     matched.
+//   - bool: true if a match was found; false otherwise.
+//
+// Example:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(4)
+//	value, ok := q.Find(func(v int) bool { return v%2 == 0 })
+//	if ok {
+//	    fmt.Println(value) // 4
+//	}
+func (q *Queue[T]) Find(fn func(T) bool) (T, bool) {
+	for i := 0; i < q.count; i++ {
+		if v := q.at(i); fn(v) {
+			return v, true
+		}
+	}
+	var zero T
+	return zero, false
+}
@@ -0,0 +1,96 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIteratorForward(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	it := q.Iterator()
+	got := []int{}
+	for it.Next() {
+		got = append(got, it.Value())
+		if it.Index() != len(got)-1 {
+			t.Errorf("expected index %d, got %d", len(got)-1, it.Index())
+		}
+	}
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("unexpected iteration order: %v", got)
+	}
+	if it.Err() != nil {
+		t.Errorf("unexpected error after exhausting iterator: %v", it.Err())
+	}
+}
+
+func TestIteratorConcurrentModification(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	it := q.Iterator()
+	it.Next()
+	q.Enqueue(3)
+	if it.Next() {
+		t.Error("expected Next to return false after concurrent modification")
+	}
+	if !errors.Is(it.Err(), ErrConcurrentModification) {
+		t.Errorf("expected ErrConcurrentModification, got %v", it.Err())
+	}
+}
+
+func TestReverseIterator(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	it := q.ReverseIterator()
+	got := []int{}
+	for it.Prev() {
+		got = append(got, it.Value())
+	}
+	if len(got) != 3 || got[0] != 3 || got[1] != 2 || got[2] != 1 {
+		t.Errorf("unexpected reverse iteration order: %v", got)
+	}
+	if !it.Last() {
+		t.Error("expected Last to find an element")
+	}
+	if it.Value() != 3 {
+		t.Errorf("expected Last to land on 3, got %d", it.Value())
+	}
+}
+
+func TestEnumerableHelpers(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	sum := 0
+	q.Each(func(i, v int) { sum += v })
+	if sum != 6 {
+		t.Errorf("expected Each to sum to 6, got %d", sum)
+	}
+	doubled := q.Map(func(v int) int { return v * 2 })
+	if got := doubled.ToSlice(); len(got) != 3 || got[0] != 2 || got[1] != 4 || got[2] != 6 {
+		t.Errorf("unexpected Map result: %v", got)
+	}
+	evens := q.Select(func(v int) bool { return v%2 == 0 })
+	if got := evens.ToSlice(); len(got) != 1 || got[0] != 2 {
+		t.Errorf("unexpected Select result: %v", got)
+	}
+	if !q.Any(func(v int) bool { return v == 3 }) {
+		t.Error("expected Any to find 3")
+	}
+	if q.All(func(v int) bool { return v > 1 }) {
+		t.Error("expected All to be false when not every element matches")
+	}
+	value, ok := q.Find(func(v int) bool { return v%2 == 0 })
+	if !ok || value != 2 {
+		t.Errorf("expected Find to return (2, true), got (%d, %v)", value, ok)
+	}
+	if _, ok := q.Find(func(v int) bool { return v > 100 }); ok {
+		t.Error("expected Find to return false when nothing matches")
+	}
+}